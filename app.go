@@ -6,6 +6,7 @@ package fiber
 
 import (
 	"bufio"
+	"context"
 	"crypto/tls"
 	"fmt"
 	"log"
@@ -55,6 +56,10 @@ type App struct {
 	server *fasthttp.Server
 	// App settings
 	Settings *Settings
+	// shutdownCtx is cancelled by ShutdownWithContext, which in turn cancels
+	// every in-flight Ctx.Context() derived from it.
+	shutdownCtx    context.Context
+	shutdownCancel context.CancelFunc
 }
 
 // Settings holds is a struct holding the server settings
@@ -164,6 +169,51 @@ type Settings struct {
 	// Default: ".fiber.gz"
 	CompressedFileSuffix string
 
+	// HandlerTimeout is the default maximum duration a registered handler may
+	// run before the request is aborted. Per-route overrides and the
+	// request-scoped context.Context this deadline feeds into are provided
+	// by Ctx.Context() and Route.Timeout(d), in ctx.go/router.go.
+	// On timeout the router invokes ErrorHandler with ErrRequestTimeout.
+	// Default: unlimited
+	HandlerTimeout time.Duration
+
+	// OnShutdown is a list of hooks run before Shutdown/ShutdownWithContext
+	// starts draining connections, letting callers deregister from service
+	// discovery, flush metrics or close DB pools.
+	// Default: nil
+	OnShutdown []func()
+
+	// Network defines the network type used by Listen, e.g. "tcp4", "tcp6",
+	// "tcp" (dual-stack) or "unix" for a Unix domain socket at the given addr.
+	// Default: "tcp4"
+	Network string
+
+	// StreamRequestBody enables Ctx.BodyStream() to hand back fasthttp's own
+	// request body stream instead of a reader over the buffered body, once
+	// the body reaches StreamThreshold. fasthttp's own server has no
+	// request-streaming knob at the version this app is pinned to, so this
+	// only changes what BodyStream() returns, not how the body is read off
+	// the wire.
+	// Default: false
+	StreamRequestBody bool
+
+	// StreamThreshold is the request body size, in bytes, at or above which
+	// Ctx.BodyStream() returns fasthttp's body stream reader instead of a
+	// reader over the already-buffered body. Only takes effect when
+	// StreamRequestBody is enabled.
+	// Default: 1 * 1024 * 1024
+	StreamThreshold int
+
+	// HTTP2 enables HTTP/2 support.
+	// Since fasthttp has no HTTP/2 implementation, enabling this option makes
+	// Listen/Serve, when given a *tls.Config, serve the app over a net/http
+	// server instead, bridging every request into the existing *Ctx so
+	// routes/middleware work unchanged. Those TLS listeners negotiate "h2" via
+	// ALPN. Without a *tls.Config, Listen/Serve still serve HTTP/1.1 through
+	// fasthttp; plaintext HTTP/2 (h2c) is served by ListenH2C instead.
+	// Default: false
+	HTTP2 bool
+
 	// FEATURE: v1.13
 	// The router executes the same handler by default if StrictRouting or CaseSensitive is disabled.
 	// Enabling RedirectFixedPath will change this behaviour into a client redirect to the original route path.
@@ -206,6 +256,8 @@ var (
 		ctx.Status(code).SendString(err.Error())
 	}
 	defaultCompressedFileSuffix = ".fiber.gz"
+	defaultStreamThreshold      = 1 * 1024 * 1024
+	defaultNetwork              = "tcp4"
 )
 
 // New creates a new Fiber named instance.
@@ -224,6 +276,7 @@ func New(settings ...*Settings) *App {
 		// Set settings
 		Settings: &Settings{},
 	}
+	app.shutdownCtx, app.shutdownCancel = context.WithCancel(context.Background())
 
 	// Overwrite settings if provided
 	if len(settings) > 0 {
@@ -246,6 +299,12 @@ func New(settings ...*Settings) *App {
 	if app.Settings.CompressedFileSuffix == "" {
 		app.Settings.CompressedFileSuffix = defaultCompressedFileSuffix
 	}
+	if app.Settings.StreamThreshold <= 0 {
+		app.Settings.StreamThreshold = defaultStreamThreshold
+	}
+	if app.Settings.Network == "" {
+		app.Settings.Network = defaultNetwork
+	}
 	// Set default error
 	if app.Settings.ErrorHandler == nil {
 		app.Settings.ErrorHandler = defaultErrorHandler
@@ -416,18 +475,37 @@ func (app *App) Routes() []*Route {
 func (app *App) Serve(ln net.Listener, tlsconfig ...*tls.Config) error {
 	// Update fiber server settings
 	app.init()
-	// TLS config
-	if len(tlsconfig) > 0 {
-		ln = tls.NewListener(ln, tlsconfig[0])
-	}
 	// Print startup message
 	if !app.Settings.DisableStartupMessage {
 		app.startupMessage(ln.Addr().String())
 	}
-
+	// Serve over HTTP/2 when enabled and a TLS config was provided. serveHTTP2
+	// wraps ln itself, after negotiating "h2" into tlsconfig[0], so ALPN
+	// actually offers it during the handshake.
+	if app.Settings.HTTP2 && len(tlsconfig) > 0 {
+		return app.serveHTTP2(ln, tlsconfig[0])
+	}
+	// TLS config
+	if len(tlsconfig) > 0 {
+		ln = tls.NewListener(ln, tlsconfig[0])
+	}
 	return app.server.Serve(ln)
 }
 
+// removeStaleUnixSocket deletes a pre-existing socket file at addr before
+// binding it. Unlike a TCP port, a unix socket's inode outlives the process
+// that created it, so without this a restart against the same addr fails
+// with "address already in use" forever until someone deletes it by hand.
+func removeStaleUnixSocket(network, addr string) error {
+	if network != "unix" {
+		return nil
+	}
+	if err := os.Remove(addr); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
 // Listen serves HTTP requests from the given addr or port.
 // You can pass an optional *tls.Config to enable TLS.
 func (app *App) Listen(address interface{}, tlsconfig ...*tls.Config) error {
@@ -440,7 +518,7 @@ func (app *App) Listen(address interface{}, tlsconfig ...*tls.Config) error {
 		}
 		addr = strconv.Itoa(port)
 	}
-	if !strings.Contains(addr, ":") {
+	if app.Settings.Network != "unix" && !strings.Contains(addr, ":") {
 		addr = ":" + addr
 	}
 	// Update fiber server settings
@@ -453,11 +531,20 @@ func (app *App) Listen(address interface{}, tlsconfig ...*tls.Config) error {
 	if app.Settings.Prefork {
 		return app.prefork(addr, tlsconfig...)
 	}
+	if err := removeStaleUnixSocket(app.Settings.Network, addr); err != nil {
+		return err
+	}
 	// Setup listener
-	ln, err := net.Listen("tcp4", addr)
+	ln, err := net.Listen(app.Settings.Network, addr)
 	if err != nil {
 		return err
 	}
+	// Serve over HTTP/2 when enabled and a TLS config was provided. serveHTTP2
+	// wraps ln itself, after negotiating "h2" into tlsconfig[0], so ALPN
+	// actually offers it during the handshake.
+	if app.Settings.HTTP2 && len(tlsconfig) > 0 {
+		return app.serveHTTP2(ln, tlsconfig[0])
+	}
 	// Add TLS config if provided
 	if len(tlsconfig) > 0 {
 		ln = tls.NewListener(ln, tlsconfig[0])
@@ -466,6 +553,39 @@ func (app *App) Listen(address interface{}, tlsconfig ...*tls.Config) error {
 	return app.server.Serve(ln)
 }
 
+// ListenH2C serves HTTP requests from the given addr or port over h2c,
+// i.e. HTTP/2 without TLS, negotiated via the HTTP/1.1 Upgrade mechanism.
+// Like Listen, the address can be an `int` port or a `string` address.
+func (app *App) ListenH2C(address interface{}) error {
+	// Convert address to string
+	addr, ok := address.(string)
+	if !ok {
+		port, ok := address.(int)
+		if !ok {
+			return fmt.Errorf("listen: host must be an `int` port or `string` address")
+		}
+		addr = strconv.Itoa(port)
+	}
+	if app.Settings.Network != "unix" && !strings.Contains(addr, ":") {
+		addr = ":" + addr
+	}
+	// Update fiber server settings
+	app.init()
+	// Print startup message
+	if !app.Settings.DisableStartupMessage {
+		app.startupMessage(addr)
+	}
+	if err := removeStaleUnixSocket(app.Settings.Network, addr); err != nil {
+		return err
+	}
+	// Setup listener
+	ln, err := net.Listen(app.Settings.Network, addr)
+	if err != nil {
+		return err
+	}
+	return app.serveH2C(ln)
+}
+
 // Handler returns the server handler
 func (app *App) Handler() fasthttp.RequestHandler {
 	return app.handler
@@ -479,12 +599,47 @@ func (app *App) Handler() fasthttp.RequestHandler {
 //
 // Shutdown does not close keepalive connections so its recommended to set ReadTimeout to something else than 0.
 func (app *App) Shutdown() error {
+	return app.ShutdownWithContext(context.Background())
+}
+
+// ShutdownWithContext gracefully shuts down the server without interrupting
+// any active connections, like Shutdown, but bounds how long it waits for
+// in-flight handlers to finish by the given context's deadline.
+//
+// fasthttp (the version this app is pinned to) only exposes a blocking
+// Server.Shutdown with no deadline support, so the bound is implemented by
+// racing it against ctx here: once ctx is done, ShutdownWithContext returns
+// ctx.Err() without waiting for Server.Shutdown to finish. fasthttp keeps
+// draining in the background regardless, but any connections still open
+// past the deadline are not forcibly closed.
+//
+// Before draining starts, every hook registered in Settings.OnShutdown is
+// run, and every in-flight Ctx.Context() is cancelled, so handlers using it
+// for database/sql or gRPC calls can abort early instead of running out
+// the clock.
+func (app *App) ShutdownWithContext(ctx context.Context) error {
 	app.mutex.Lock()
-	defer app.mutex.Unlock()
-	if app.server == nil {
+	server := app.server
+	app.mutex.Unlock()
+	if server == nil {
 		return fmt.Errorf("shutdown: server is not running")
 	}
-	return app.server.Shutdown()
+	for _, hook := range app.Settings.OnShutdown {
+		hook()
+	}
+	app.shutdownCancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- server.Shutdown()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // Test is used for internal debugging by passing a *http.Request
@@ -0,0 +1,55 @@
+package fiber
+
+// HTTP methods were copied from net/http.
+const (
+	MethodGet     = "GET"
+	MethodHead    = "HEAD"
+	MethodPost    = "POST"
+	MethodPut     = "PUT"
+	MethodDelete  = "DELETE"
+	MethodConnect = "CONNECT"
+	MethodOptions = "OPTIONS"
+	MethodTrace   = "TRACE"
+	MethodPatch   = "PATCH"
+	methodUse     = "USE"
+)
+
+// methodINT maps an HTTP method to its position in App.stack.
+var methodINT = map[string]int{
+	MethodGet:     0,
+	MethodHead:    1,
+	MethodPost:    2,
+	MethodPut:     3,
+	MethodDelete:  4,
+	MethodConnect: 5,
+	MethodOptions: 6,
+	MethodTrace:   7,
+	MethodPatch:   8,
+	methodUse:     9,
+}
+
+// A small subset of HTTP status codes this package references directly.
+const (
+	StatusOK                          = 200
+	StatusBadRequest                  = 400
+	StatusNotFound                    = 404
+	StatusRequestTimeout              = 408
+	StatusRequestEntityTooLarge       = 413
+	StatusRequestHeaderFieldsTooLarge = 431
+	StatusInternalServerError         = 500
+)
+
+// HeaderContentType is the canonical "Content-Type" header name.
+const HeaderContentType = "Content-Type"
+
+// MIMETextPlainCharsetUTF8 is the MIME type used by the default ErrorHandler.
+const MIMETextPlainCharsetUTF8 = "text/plain; charset=utf-8"
+
+// Errors returned by the router/server for conditions that have no
+// user-registered handler to call NewError from directly.
+var (
+	ErrBadRequest                  = NewError(StatusBadRequest, "Bad Request")
+	ErrRequestTimeout              = NewError(StatusRequestTimeout, "Request Timeout")
+	ErrRequestEntityTooLarge       = NewError(StatusRequestEntityTooLarge, "Request Entity Too Large")
+	ErrRequestHeaderFieldsTooLarge = NewError(StatusRequestHeaderFieldsTooLarge, "Request Header Fields Too Large")
+)
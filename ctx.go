@@ -0,0 +1,79 @@
+package fiber
+
+import (
+	"context"
+
+	utils "github.com/gofiber/utils"
+	fasthttp "github.com/valyala/fasthttp"
+)
+
+// Ctx represents the Context which hold the *fasthttp.RequestCtx.
+// It's used to store/retrieve values through the middleware stack chain.
+type Ctx struct {
+	app      *App
+	route    *Route
+	Fasthttp *fasthttp.RequestCtx
+	err      error
+	context  context.Context
+	cancel   context.CancelFunc
+}
+
+// AcquireCtx retrieves a pooled *Ctx and binds it to the given fasthttp
+// request, avoiding an allocation per request in the common case.
+func (app *App) AcquireCtx(fctx *fasthttp.RequestCtx) *Ctx {
+	ctx := app.pool.Get().(*Ctx)
+	ctx.app = app
+	ctx.Fasthttp = fctx
+	return ctx
+}
+
+// ReleaseCtx resets ctx and returns it to the pool.
+func (app *App) ReleaseCtx(ctx *Ctx) {
+	ctx.route = nil
+	ctx.Fasthttp = nil
+	ctx.err = nil
+	ctx.context = nil
+	ctx.cancel = nil
+	app.pool.Put(ctx)
+}
+
+// Context returns a context.Context scoped to this request. Its deadline is
+// derived from the route's own Timeout, falling back to Settings.HandlerTimeout,
+// and it is cancelled when that deadline passes or the server starts shutting
+// down. A client disconnecting does not cancel it. Pass it to database/sql,
+// gRPC clients or http.NewRequestWithContext for cancellation propagation.
+//
+// When no timeout applies to the route this returns the app's shutdown
+// context directly rather than allocating a new one, keeping the steady-state
+// dispatch path allocation-free.
+func (ctx *Ctx) Context() context.Context {
+	if ctx.context != nil {
+		return ctx.context
+	}
+	return ctx.app.shutdownCtx
+}
+
+// Set sets the response header entry with the given key to the given value.
+func (ctx *Ctx) Set(key, val string) {
+	ctx.Fasthttp.Response.Header.Set(key, val)
+}
+
+// Status sets the HTTP status for the response and returns ctx for chaining.
+func (ctx *Ctx) Status(status int) *Ctx {
+	ctx.Fasthttp.Response.SetStatusCode(status)
+	return ctx
+}
+
+// SendString sets the response body to s.
+func (ctx *Ctx) SendString(s string) {
+	ctx.Fasthttp.Response.SetBodyString(s)
+}
+
+// SendStatus sets the HTTP status and, if the body is still empty, the
+// status's default text description as the body.
+func (ctx *Ctx) SendStatus(status int) {
+	ctx.Status(status)
+	if len(ctx.Fasthttp.Response.Body()) == 0 {
+		ctx.SendString(utils.StatusMessage(status))
+	}
+}
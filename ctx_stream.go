@@ -0,0 +1,41 @@
+package fiber
+
+import (
+	"bytes"
+	"io"
+)
+
+// BodyStream returns the request body as an io.Reader, allowing handlers to
+// process large uploads incrementally instead of buffering the whole body
+// in memory. It only hands back fasthttp's own body stream when
+// Settings.StreamRequestBody is enabled and the declared Content-Length is
+// at or above Settings.StreamThreshold; otherwise it wraps the already
+// buffered Body() in a bytes.Reader.
+//
+// The Content-Length check here deliberately never calls Request.Body():
+// doing that first, then deciding whether to stream, would force the body
+// into memory before the decision is even made, defeating the point of
+// streaming it. Request.BodyStream() is fasthttp's own streaming accessor
+// for the body it read off the wire; it is only genuinely incremental when
+// the server itself is configured to stream request bodies above a given
+// size, which this fasthttp version has no exported knob for, so below
+// that size (or with StreamRequestBody disabled) the body is already
+// buffered and Body() is the correct accessor.
+func (ctx *Ctx) BodyStream() io.Reader {
+	if ctx.app.Settings.StreamRequestBody && ctx.Fasthttp.Request.Header.ContentLength() >= ctx.app.Settings.StreamThreshold {
+		return ctx.Fasthttp.Request.BodyStream()
+	}
+	return bytes.NewReader(ctx.Fasthttp.Request.Body())
+}
+
+// SendStream sets the response body to the given io.Reader, so it is
+// written out in chunks instead of being buffered in full. An optional
+// size can be provided when the total length is known in advance, which
+// avoids a chunked transfer encoding.
+func (ctx *Ctx) SendStream(stream io.Reader, size ...int) {
+	if len(size) > 0 && size[0] >= 0 {
+		ctx.Fasthttp.Response.SetBodyStream(stream, size[0])
+	} else {
+		ctx.Fasthttp.Response.SetBodyStream(stream, -1)
+	}
+}
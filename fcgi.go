@@ -0,0 +1,46 @@
+package fiber
+
+import (
+	"fmt"
+	"net"
+	"net/http/fcgi"
+	"strconv"
+	"strings"
+)
+
+// ServeFCGI runs the app as a FastCGI responder on the given listener,
+// translating each incoming FastCGI request into the existing handler
+// path via bridgeHandler so all registered routes/middleware work unchanged.
+// This lets fiber be deployed behind nginx/Apache/Caddy using fcgi_pass.
+func (app *App) ServeFCGI(ln net.Listener) error {
+	// Update fiber server settings
+	app.init()
+	// Print startup message
+	if !app.Settings.DisableStartupMessage {
+		app.startupMessage(ln.Addr().String())
+	}
+	return fcgi.Serve(ln, app.bridgeHandler())
+}
+
+// ListenFCGI serves the app as a FastCGI responder from the given addr or port.
+func (app *App) ListenFCGI(address interface{}) error {
+	addr, ok := address.(string)
+	if !ok {
+		port, ok := address.(int)
+		if !ok {
+			return fmt.Errorf("listen: host must be an `int` port or `string` address")
+		}
+		addr = strconv.Itoa(port)
+	}
+	if app.Settings.Network != "unix" && !strings.Contains(addr, ":") {
+		addr = ":" + addr
+	}
+	if err := removeStaleUnixSocket(app.Settings.Network, addr); err != nil {
+		return err
+	}
+	ln, err := net.Listen(app.Settings.Network, addr)
+	if err != nil {
+		return err
+	}
+	return app.ServeFCGI(ln)
+}
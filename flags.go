@@ -0,0 +1,9 @@
+package fiber
+
+// Command-line flags recognized via utils.GetArgument: flagPrefork turns on
+// Settings.Prefork, and flagChild tells a forked Prefork child apart from
+// the parent process.
+const (
+	flagPrefork = "-prefork"
+	flagChild   = "-child"
+)
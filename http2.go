@@ -0,0 +1,85 @@
+package fiber
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"net/http"
+
+	fasthttp "github.com/valyala/fasthttp"
+	http2 "golang.org/x/net/http2"
+	h2c "golang.org/x/net/http2/h2c"
+)
+
+// serveHTTP2 serves ln over TLS using HTTP/2, negotiated via ALPN.
+// Requests are bridged into the existing fasthttp handler so every
+// registered route/middleware runs unchanged.
+//
+// tlsConfig must be the config the caller is about to hand the TLS listener.
+// ConfigureServer has to mutate it (adding "h2" to NextProtos) before that
+// listener is built, not after: ALPN is negotiated during the handshake
+// using whatever config wraps the listener, so configuring a separate,
+// freshly-allocated *tls.Config here would never take effect. The listener
+// itself is therefore built inside this function, after ConfigureServer runs.
+func (app *App) serveHTTP2(ln net.Listener, tlsConfig *tls.Config) error {
+	srv := &http.Server{Handler: app.bridgeHandler(), TLSConfig: tlsConfig}
+	if err := http2.ConfigureServer(srv, &http2.Server{}); err != nil {
+		return err
+	}
+	return srv.Serve(tls.NewListener(ln, srv.TLSConfig))
+}
+
+// serveH2C serves ln over plaintext HTTP/2, upgraded from HTTP/1.1 via h2c.
+func (app *App) serveH2C(ln net.Listener) error {
+	h2s := &http2.Server{}
+	srv := &http.Server{Handler: h2c.NewHandler(app.bridgeHandler(), h2s)}
+	return srv.Serve(ln)
+}
+
+// bridgeHandler returns an http.Handler that converts each incoming
+// http.Request/http.ResponseWriter pair into a fasthttp.RequestCtx and
+// dispatches it through app.handler, so the net/http-backed HTTP/2 path
+// reuses the exact same routing and middleware as the fasthttp path.
+func (app *App) bridgeHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var fctx fasthttp.RequestCtx
+		var req fasthttp.Request
+
+		req.Header.SetMethod(r.Method)
+		req.SetRequestURI(r.URL.RequestURI())
+		req.Header.SetHost(r.Host)
+		for key, values := range r.Header {
+			for _, value := range values {
+				req.Header.Add(key, value)
+			}
+		}
+		if r.Body != nil {
+			// Enforce Settings.BodyLimit here too: app.server.MaxRequestBodySize
+			// only guards the native fasthttp listener, not this net/http bridge.
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, int64(app.Settings.BodyLimit)))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			req.SetBody(body)
+		}
+
+		fctx.Init(&req, getRemoteAddr(r), nil)
+		app.handler(&fctx)
+
+		fctx.Response.Header.VisitAll(func(key, value []byte) {
+			w.Header().Add(string(key), string(value))
+		})
+		w.WriteHeader(fctx.Response.StatusCode())
+		_, _ = w.Write(fctx.Response.Body())
+	})
+}
+
+// getRemoteAddr extracts the client address from an *http.Request,
+// falling back to a zero address when it cannot be parsed.
+func getRemoteAddr(r *http.Request) net.Addr {
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		return addr
+	}
+	return &net.TCPAddr{}
+}
@@ -0,0 +1,158 @@
+package fiber
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	fasthttp "github.com/valyala/fasthttp"
+)
+
+// Route is a struct that holds all metadata for each registered handler chain.
+type Route struct {
+	// pos is this route's position in the stack, used to preserve
+	// registration order when Routes() merges all the per-method stacks.
+	pos uint32
+
+	Method   string
+	Path     string
+	Name     string
+	Handlers []Handler
+
+	// timeout overrides Settings.HandlerTimeout for this route when positive.
+	timeout time.Duration
+}
+
+// Timeout sets a per-route maximum duration for the handler chain to run,
+// overriding Settings.HandlerTimeout. On expiry the router aborts the
+// response and invokes Settings.ErrorHandler with ErrRequestTimeout.
+//
+//  app.Get("/slow", handler).Timeout(5 * time.Second)
+func (r *Route) Timeout(d time.Duration) *Route {
+	r.timeout = d
+	return r
+}
+
+// register adds a new Route for the given method/prefix to the route stack.
+func (app *App) register(method, prefix string, handlers ...Handler) *Route {
+	if prefix == "" {
+		prefix = "/"
+	}
+	route := &Route{
+		pos:      uint32(app.routes),
+		Method:   method,
+		Path:     prefix,
+		Name:     prefix,
+		Handlers: handlers,
+	}
+	app.mutex.Lock()
+	app.stack[methodINT[method]] = append(app.stack[methodINT[method]], route)
+	app.routes++
+	app.mutex.Unlock()
+	return route
+}
+
+// matchRoute returns the first registered route whose method and path match
+// the incoming request, falling back to prefix-matched "USE" middleware routes.
+func (app *App) matchRoute(method, path string) *Route {
+	if i, ok := methodINT[method]; ok {
+		for _, route := range app.stack[i] {
+			if route.Path == path {
+				return route
+			}
+		}
+	}
+	for _, route := range app.stack[methodINT[methodUse]] {
+		if strings.HasPrefix(path, route.Path) {
+			return route
+		}
+	}
+	return nil
+}
+
+// dispatch runs a route's handler chain against ctx.
+func dispatch(ctx *Ctx, route *Route) {
+	for _, handler := range route.Handlers {
+		handler(ctx)
+	}
+}
+
+// handler is the fasthttp.RequestHandler bound to the server. It acquires a
+// pooled *Ctx, matches the request against the route stack, and dispatches
+// into the handler chain, enforcing the route's timeout when one applies.
+func (app *App) handler(fctx *fasthttp.RequestCtx) {
+	ctx := app.AcquireCtx(fctx)
+
+	// getString is a zero-copy []byte->string view by default (see utils.go),
+	// so matching a route costs no allocation on the steady-state path.
+	route := app.matchRoute(getString(fctx.Method()), getString(fctx.Path()))
+	if route == nil {
+		fctx.SetStatusCode(StatusNotFound)
+		app.ReleaseCtx(ctx)
+		return
+	}
+	ctx.route = route
+
+	timeout := route.timeout
+	if timeout <= 0 {
+		timeout = app.Settings.HandlerTimeout
+	}
+	if timeout <= 0 {
+		dispatch(ctx, route)
+		app.ReleaseCtx(ctx)
+		return
+	}
+	app.dispatchWithTimeout(ctx, route, timeout)
+}
+
+// dispatchWithTimeout runs route's handler chain on a separate goroutine so
+// it can be abandoned at the deadline, the same shape as net/http's
+// TimeoutHandler and fasthttp's own TimeoutHandler: Go gives no way to
+// forcibly kill a running goroutine, so the original handler keeps running
+// in the background after we give up waiting on it.
+//
+// Letting it keep writing into ctx.Fasthttp unsupervised would be a bug, not
+// just a wasted goroutine: fasthttp recycles that *fasthttp.RequestCtx for
+// the connection's next request as soon as this function returns, so a late
+// write from the orphaned goroutine would land on a completely unrelated
+// client's response. ctx.Fasthttp.TimeoutErrorWithResponse is fasthttp's own
+// answer to this (the same call fasthttp.TimeoutHandler makes internally):
+// it snapshots whatever response we've built so far and swaps it in as the
+// one actually sent, so writes the abandoned goroutine makes afterwards hit
+// a discarded copy instead of the recycled ctx.
+//
+// Our own *Ctx wrapper still isn't safe to hand to the next AcquireCtx while
+// that goroutine is running (dispatch closes over it directly), so its
+// release back to app.pool is deferred until <-done regardless of which
+// branch fires below.
+func (app *App) dispatchWithTimeout(ctx *Ctx, route *Route, timeout time.Duration) {
+	tctx, cancel := context.WithTimeout(app.shutdownCtx, timeout)
+	ctx.context = tctx
+	ctx.cancel = cancel
+
+	done := make(chan struct{})
+	go func() {
+		dispatch(ctx, route)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		cancel()
+		app.ReleaseCtx(ctx)
+		return
+	case <-ctx.Fasthttp.Done():
+		// server is shutting down (fasthttp closes this channel on
+		// Server.Shutdown); nothing to send, but still snapshot below.
+	case <-tctx.Done():
+		ctx.err = ErrRequestTimeout
+		app.Settings.ErrorHandler(ctx, ctx.err)
+	}
+
+	cancel()
+	ctx.Fasthttp.TimeoutErrorWithResponse(&ctx.Fasthttp.Response)
+	go func() {
+		<-done
+		app.ReleaseCtx(ctx)
+	}()
+}
@@ -0,0 +1,114 @@
+package fiber
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+
+	utils "github.com/gofiber/utils"
+)
+
+// ListenSocketActivation serves HTTP requests using file descriptors passed
+// in by a process supervisor (systemd, launchd) via socket activation, as
+// described by LISTEN_PID/LISTEN_FDS. When Settings.Prefork is enabled, the
+// parent re-execs itself once per CPU, passing the same activation files
+// down via ExtraFiles so every child shares the inherited sockets instead
+// of re-binding the address.
+func (app *App) ListenSocketActivation(tlsconfig ...*tls.Config) error {
+	files, err := activationFiles()
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("listen: no sockets passed via LISTEN_FDS")
+	}
+
+	if app.Settings.Prefork && !utils.GetArgument(flagChild) {
+		return preforkSocketActivation(files)
+	}
+
+	lns := make([]net.Listener, len(files))
+	for i, f := range files {
+		ln, err := net.FileListener(f)
+		if err != nil {
+			return err
+		}
+		lns[i] = ln
+	}
+
+	// Update fiber server settings
+	app.init()
+	// Print startup message
+	if !app.Settings.DisableStartupMessage {
+		app.startupMessage(lns[0].Addr().String())
+	}
+	errs := make(chan error, len(lns))
+	for _, ln := range lns {
+		ln := ln
+		if len(tlsconfig) > 0 {
+			ln = tls.NewListener(ln, tlsconfig[0])
+		}
+		go func() {
+			errs <- app.server.Serve(ln)
+		}()
+	}
+	return <-errs
+}
+
+// activationFiles inspects LISTEN_PID/LISTEN_FDS and returns the raw
+// *os.File for each fd handed off by the supervisor, starting at fd 3 as
+// specified by the systemd socket activation protocol.
+//
+// A process started by preforkSocketActivation is trusted unconditionally
+// and skips the LISTEN_PID check: LISTEN_PID can only ever name the single
+// process systemd/launchd originally activated, never a child that process
+// forks afterwards, so enforcing it here would make Prefork impossible to
+// combine with socket activation.
+func activationFiles() ([]*os.File, error) {
+	if !utils.GetArgument(flagChild) {
+		pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+		if err != nil || pid != os.Getpid() {
+			return nil, nil
+		}
+	}
+	nfds, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || nfds == 0 {
+		return nil, nil
+	}
+	const firstActivationFd = 3
+	files := make([]*os.File, 0, nfds)
+	for fd := firstActivationFd; fd < firstActivationFd+nfds; fd++ {
+		files = append(files, os.NewFile(uintptr(fd), fmt.Sprintf("LISTEN_FD_%d", fd)))
+	}
+	return files, nil
+}
+
+// preforkSocketActivation spawns one child per CPU, each re-exec'd with
+// flagChild and the same activation files passed again via ExtraFiles
+// (landing at fd 3.. in the child, same as systemd's own convention), so
+// every child inherits the sockets instead of re-binding the address.
+func preforkSocketActivation(files []*os.File) error {
+	childCount := runtime.GOMAXPROCS(0)
+	errs := make(chan error, childCount)
+
+	for i := 0; i < childCount; i++ {
+		cmd := exec.Command(os.Args[0], append(os.Args[1:], flagChild)...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		cmd.ExtraFiles = files
+		cmd.Env = append(os.Environ(), fmt.Sprintf("LISTEN_FDS=%d", len(files)))
+
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		cmd := cmd
+		go func() {
+			errs <- cmd.Wait()
+		}()
+	}
+	return <-errs
+}
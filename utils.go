@@ -0,0 +1,43 @@
+package fiber
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// getBytes and getString perform zero-copy []byte<->string conversions by
+// default, to keep the hot request-handling path allocation-free.
+// Settings.Immutable swaps them for the always-copying variants below,
+// since the zero-copy result aliases memory fasthttp reuses once the
+// handler returns.
+var (
+	getBytes  = getBytesMutable
+	getString = getStringMutable
+)
+
+// getBytesMutable converts s to a []byte without copying, by pointing the
+// slice header at the string's own backing array. The result must not be
+// mutated, and is only valid while s (or its source buffer) is alive.
+func getBytesMutable(s string) (b []byte) {
+	sh := (*reflect.StringHeader)(unsafe.Pointer(&s))
+	bh := (*reflect.SliceHeader)(unsafe.Pointer(&b))
+	bh.Data = sh.Data
+	bh.Len = sh.Len
+	bh.Cap = sh.Len
+	return
+}
+
+// getStringMutable converts b to a string without copying.
+func getStringMutable(b []byte) string {
+	return *(*string)(unsafe.Pointer(&b))
+}
+
+// getBytesImmutable always copies.
+func getBytesImmutable(s string) []byte {
+	return []byte(s)
+}
+
+// getStringImmutable always copies.
+func getStringImmutable(b []byte) string {
+	return string(b)
+}